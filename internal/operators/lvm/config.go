@@ -0,0 +1,55 @@
+package lvm
+
+import "github.com/openshift/assisted-service/models"
+
+// LvmMinOpenshiftVersion is the minimum OCP version that supports the LVM Storage operator
+const LvmMinOpenshiftVersion = "4.12.0"
+
+// LvmMinOpenshiftVersionMultiNode is the minimum OCP version that supports LVMS on
+// multi-node (compact or standard) clusters, as opposed to Single Node Openshift only
+const LvmMinOpenshiftVersionMultiNode = "4.13.0"
+
+type Config struct {
+	LvmCPUPerHost       int64 `envconfig:"LVM_CPU_PER_HOST" default:"1"`
+	LvmMemoryPerHostMiB int64 `envconfig:"LVM_MEMORY_PER_HOST_MIB" default:"1200"`
+
+	// EnableMultiNode allows ODF LVM to be validated and deployed on compact and
+	// worker-containing clusters, in addition to Single Node Openshift
+	EnableMultiNode bool `envconfig:"LVM_ENABLE_MULTI_NODE" default:"false"`
+
+	// AdditionalValidDriveTypes lets deployments opt extra models.DriveType values into
+	// getValidDiskCount, on top of the SSD/HDD/NVMe types counted by default.
+	AdditionalValidDriveTypes []string `envconfig:"LVM_ADDITIONAL_VALID_DRIVE_TYPES" default:""`
+
+	// WipeDevices allow-lists the device paths that the pre-installation disk-wipe
+	// MachineConfig is permitted to run wipefs/sgdisk against. A deviceClass's
+	// DeviceSelector path is only wiped if it also appears here, and the installation
+	// disk is never wiped regardless of this setting.
+	WipeDevices []string `envconfig:"LVM_WIPE_DEVICES" default:""`
+}
+
+// getValidDiskCount counts the disks that are eligible to back an LVM volume group: not the
+// installation disk, of non-zero size, and of drive type ssd, hdd, nvme or one listed in
+// Config.AdditionalValidDriveTypes
+func (o *operator) getValidDiskCount(disks []*models.Disk, installationDiskID string) (int64, error) {
+	var countDisks int64
+
+	for _, disk := range disks {
+		if o.isValidDriveType(disk.DriveType) && installationDiskID != disk.ID && disk.SizeBytes != 0 {
+			countDisks++
+		}
+	}
+	return countDisks, nil
+}
+
+func (o *operator) isValidDriveType(driveType models.DriveType) bool {
+	if driveType == models.DriveTypeHDD || driveType == models.DriveTypeSSD || driveType == models.DriveTypeNVMe {
+		return true
+	}
+	for _, additional := range o.config.AdditionalValidDriveTypes {
+		if string(driveType) == additional {
+			return true
+		}
+	}
+	return false
+}
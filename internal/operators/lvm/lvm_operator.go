@@ -71,24 +71,34 @@ func (o *operator) GetHostValidationID() string {
 
 // ValidateCluster always return "valid" result
 func (o *operator) ValidateCluster(_ context.Context, cluster *common.Cluster) (api.ValidationResult, error) {
-	if !common.IsSingleNodeCluster(cluster) {
-		message := "ODF LVM operator is only supported for Single Node Openshift deployment"
-		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetClusterValidationID(), Reasons: []string{message}}, nil
+	ocpVersion, err := version.NewVersion(cluster.OpenshiftVersion)
+	if err != nil {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{err.Error()}}, nil
 	}
 
-	var ocpVersion, minOpenshiftVersionForLvm *version.Version
-	var err error
+	if common.IsSingleNodeCluster(cluster) {
+		minOpenshiftVersionForLvm, verErr := version.NewVersion(LvmMinOpenshiftVersion)
+		if verErr != nil {
+			return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{verErr.Error()}}, nil
+		}
+		if ocpVersion.LessThan(minOpenshiftVersionForLvm) {
+			message := "ODF LVM operator is only supported for openshift versions 4.12.0 and above"
+			return api.ValidationResult{Status: api.Failure, ValidationId: o.GetClusterValidationID(), Reasons: []string{message}}, nil
+		}
+		return api.ValidationResult{Status: api.Success, ValidationId: o.GetClusterValidationID()}, nil
+	}
 
-	ocpVersion, err = version.NewVersion(cluster.OpenshiftVersion)
-	if err != nil {
-		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{err.Error()}}, nil
+	if !o.config.EnableMultiNode {
+		message := "ODF LVM operator is only supported for Single Node Openshift deployment"
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetClusterValidationID(), Reasons: []string{message}}, nil
 	}
-	minOpenshiftVersionForLvm, err = version.NewVersion(LvmMinOpenshiftVersion)
+
+	minOpenshiftVersionForMultiNodeLvm, err := version.NewVersion(LvmMinOpenshiftVersionMultiNode)
 	if err != nil {
 		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{err.Error()}}, nil
 	}
-	if ocpVersion.LessThan(minOpenshiftVersionForLvm) {
-		message := "ODF LVM operator is only supported for openshift versions 4.12.0 and above"
+	if ocpVersion.LessThan(minOpenshiftVersionForMultiNodeLvm) {
+		message := fmt.Sprintf("ODF LVM operator on multi-node clusters is only supported for openshift versions %s and above", LvmMinOpenshiftVersionMultiNode)
 		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetClusterValidationID(), Reasons: []string{message}}, nil
 	}
 
@@ -142,8 +152,12 @@ func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, ho
 }
 
 // GenerateManifests generates manifests for the operator
-func (o *operator) GenerateManifests(_ *common.Cluster) (map[string][]byte, []byte, error) {
-	return Manifests()
+func (o *operator) GenerateManifests(cluster *common.Cluster) (map[string][]byte, []byte, error) {
+	spec, err := lvmClusterSpec(cluster, deviceClassGroups(cluster))
+	if err != nil {
+		return nil, nil, err
+	}
+	return Manifests(spec, o.wipeDeviceGroups(cluster, spec))
 }
 
 // GetProperties provides description of operator properties: none required
@@ -157,33 +171,39 @@ func (o *operator) GetMonitoredOperator() *models.MonitoredOperator {
 }
 
 // GetHostRequirements provides operator's requirements towards the host
-func (o *operator) GetHostRequirements(ctx context.Context, cluster *common.Cluster, _ *models.Host) (*models.ClusterHostRequirementsDetails, error) {
+func (o *operator) GetHostRequirements(ctx context.Context, cluster *common.Cluster, host *models.Host) (*models.ClusterHostRequirementsDetails, error) {
 	log := logutil.FromContext(ctx, o.log)
 	preflightRequirements, err := o.GetPreflightRequirements(ctx, cluster)
 	if err != nil {
 		log.WithError(err).Errorf("Cannot retrieve preflight requirements for cluster %s", cluster.ID)
 		return nil, err
 	}
+	if host != nil && host.Role == models.HostRoleWorker {
+		return preflightRequirements.Requirements.Worker.Quantitative, nil
+	}
 	return preflightRequirements.Requirements.Master.Quantitative, nil
 }
 
 // GetPreflightRequirements returns operator hardware requirements that can be determined with cluster data only
 func (o *operator) GetPreflightRequirements(_ context.Context, cluster *common.Cluster) (*models.OperatorHardwareRequirements, error) {
+	qualitative := []string{
+		"At least 1 non-installation disk (SSD, HDD or NVMe) wih no partitions or filesystems",
+	}
+	quantitative := &models.ClusterHostRequirementsDetails{
+		CPUCores: o.config.LvmCPUPerHost,
+		RAMMib:   o.config.LvmMemoryPerHostMiB,
+	}
 	return &models.OperatorHardwareRequirements{
 		OperatorName: o.GetName(),
 		Dependencies: o.GetDependencies(cluster),
 		Requirements: &models.HostTypeHardwareRequirementsWrapper{
 			Master: &models.HostTypeHardwareRequirements{
-				Quantitative: &models.ClusterHostRequirementsDetails{
-					CPUCores: o.config.LvmCPUPerHost,
-					RAMMib:   o.config.LvmMemoryPerHostMiB,
-				},
-				Qualitative: []string{
-					"At least 1 non-installation disk wih no partitions or filesystems",
-				},
+				Quantitative: quantitative,
+				Qualitative:  qualitative,
 			},
 			Worker: &models.HostTypeHardwareRequirements{
-				Quantitative: &models.ClusterHostRequirementsDetails{},
+				Quantitative: quantitative,
+				Qualitative:  qualitative,
 			},
 		},
 	}, nil
@@ -2,6 +2,7 @@ package lvm
 
 import (
 	"context"
+	"fmt"
 
 	. "github.com/onsi/ginkgo"
 	"github.com/onsi/ginkgo/extensions/table"
@@ -90,6 +91,88 @@ var _ = Describe("Lvm Operator", func() {
 				},
 			}),
 		}
+		hostWithNvmeOnlyDisks = &models.Host{
+			InstallationDiskID: diskID1,
+			Inventory: Inventory(&InventoryResources{
+				Cpus: 12,
+				Ram:  32 * conversions.GiB,
+				Disks: []*models.Disk{
+					{
+						SizeBytes: 20 * conversions.GB,
+						DriveType: models.DriveTypeNVMe,
+						ID:        diskID1,
+					},
+					{
+						SizeBytes: 40 * conversions.GB,
+						DriveType: models.DriveTypeNVMe,
+						ID:        diskID2,
+					},
+				},
+			}),
+		}
+		hostWithMixedNvmeAndSsdDisks = &models.Host{
+			InstallationDiskID: diskID1,
+			Inventory: Inventory(&InventoryResources{
+				Cpus: 12,
+				Ram:  32 * conversions.GiB,
+				Disks: []*models.Disk{
+					{
+						SizeBytes: 20 * conversions.GB,
+						DriveType: models.DriveTypeHDD,
+						ID:        diskID1,
+					},
+					{
+						SizeBytes: 40 * conversions.GB,
+						DriveType: models.DriveTypeSSD,
+						ID:        diskID2,
+					},
+					{
+						SizeBytes: 40 * conversions.GB,
+						DriveType: models.DriveTypeNVMe,
+						ID:        "/dev/disk/by-id/test-disk-3",
+					},
+				},
+			}),
+		}
+		hostWithNvmeInstallationDisk = &models.Host{
+			InstallationDiskID: diskID1,
+			Inventory: Inventory(&InventoryResources{
+				Cpus: 12,
+				Ram:  32 * conversions.GiB,
+				Disks: []*models.Disk{
+					{
+						SizeBytes: 20 * conversions.GB,
+						DriveType: models.DriveTypeNVMe,
+						ID:        diskID1,
+					},
+					{
+						SizeBytes: 40 * conversions.GB,
+						DriveType: models.DriveTypeSSD,
+						ID:        diskID2,
+					},
+				},
+			}),
+		}
+		workerHostWithSufficientResources = &models.Host{
+			Role:               models.HostRoleWorker,
+			InstallationDiskID: diskID1,
+			Inventory: Inventory(&InventoryResources{
+				Cpus: 12,
+				Ram:  32 * conversions.GiB,
+				Disks: []*models.Disk{
+					{
+						SizeBytes: 20 * conversions.GB,
+						DriveType: models.DriveTypeHDD,
+						ID:        diskID1,
+					},
+					{
+						SizeBytes: 40 * conversions.GB,
+						DriveType: models.DriveTypeSSD,
+						ID:        diskID2,
+					},
+				},
+			}),
+		}
 	)
 
 	Context("GetHostRequirements", func() {
@@ -135,6 +218,21 @@ var _ = Describe("Lvm Operator", func() {
 				hostWithSufficientResources,
 				api.ValidationResult{Status: api.Success, ValidationId: operator.GetHostValidationID()},
 			),
+			table.Entry("host with NVMe-only disks",
+				&common.Cluster{Cluster: models.Cluster{Hosts: []*models.Host{hostWithNvmeOnlyDisks}}},
+				hostWithNvmeOnlyDisks,
+				api.ValidationResult{Status: api.Success, ValidationId: operator.GetHostValidationID()},
+			),
+			table.Entry("host with mixed NVMe and SSD disks",
+				&common.Cluster{Cluster: models.Cluster{Hosts: []*models.Host{hostWithMixedNvmeAndSsdDisks}}},
+				hostWithMixedNvmeAndSsdDisks,
+				api.ValidationResult{Status: api.Success, ValidationId: operator.GetHostValidationID()},
+			),
+			table.Entry("host with NVMe installation disk",
+				&common.Cluster{Cluster: models.Cluster{Hosts: []*models.Host{hostWithNvmeInstallationDisk}}},
+				hostWithNvmeInstallationDisk,
+				api.ValidationResult{Status: api.Success, ValidationId: operator.GetHostValidationID()},
+			),
 		)
 	})
 	Context("ValidateCluster", func() {
@@ -159,4 +257,33 @@ var _ = Describe("Lvm Operator", func() {
 			),
 		)
 	})
+	Context("ValidateCluster with multi-node enabled", func() {
+		fullHaMode := models.ClusterHighAvailabilityModeFull
+		multiNodeOperator := newLvmOperatorWithConfig(common.GetTestLog(), &Config{EnableMultiNode: true}, nil)
+
+		table.DescribeTable("validate cluster when ", func(cluster *common.Cluster, expectedResult api.ValidationResult) {
+			res, _ := multiNodeOperator.ValidateCluster(ctx, cluster)
+			Expect(res).Should(Equal(expectedResult))
+		},
+			table.Entry("compact 3-node cluster below the multi-node minimal version",
+				&common.Cluster{Cluster: models.Cluster{HighAvailabilityMode: &fullHaMode, Hosts: []*models.Host{hostWithSufficientResources, hostWithSufficientResources, hostWithSufficientResources}, OpenshiftVersion: "4.12.0"}},
+				api.ValidationResult{Status: api.Failure, ValidationId: multiNodeOperator.GetClusterValidationID(), Reasons: []string{fmt.Sprintf("ODF LVM operator on multi-node clusters is only supported for openshift versions %s and above", LvmMinOpenshiftVersionMultiNode)}},
+			),
+			table.Entry("compact 3-node cluster",
+				&common.Cluster{Cluster: models.Cluster{HighAvailabilityMode: &fullHaMode, Hosts: []*models.Host{hostWithSufficientResources, hostWithSufficientResources, hostWithSufficientResources}, OpenshiftVersion: "4.13.0"}},
+				api.ValidationResult{Status: api.Success, ValidationId: multiNodeOperator.GetClusterValidationID()},
+			),
+			table.Entry("3 masters and 2 workers cluster",
+				&common.Cluster{Cluster: models.Cluster{
+					HighAvailabilityMode: &fullHaMode,
+					Hosts: []*models.Host{
+						hostWithSufficientResources, hostWithSufficientResources, hostWithSufficientResources,
+						workerHostWithSufficientResources, workerHostWithSufficientResources,
+					},
+					OpenshiftVersion: "4.13.0",
+				}},
+				api.ValidationResult{Status: api.Success, ValidationId: multiNodeOperator.GetClusterValidationID()},
+			),
+		)
+	})
 })
@@ -0,0 +1,111 @@
+package lvm
+
+import (
+	"encoding/json"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/pkg/errors"
+)
+
+// DeviceSelector narrows a deviceClass down to specific block devices, by path, instead of
+// letting LVMS claim every unclaimed disk on the matching nodes
+type DeviceSelector struct {
+	Paths         []string `json:"paths,omitempty"`
+	OptionalPaths []string `json:"optionalPaths,omitempty"`
+}
+
+// ThinPoolConfig configures the thin pool backing a deviceClass
+type ThinPoolConfig struct {
+	Name               string `json:"name"`
+	SizePercent        int64  `json:"sizePercent"`
+	OverprovisionRatio int64  `json:"overprovisionRatio"`
+}
+
+// LVMClusterDeviceClass is a single entry of the LVMCluster CR's storage.deviceClasses list
+type LVMClusterDeviceClass struct {
+	Name           string          `json:"name"`
+	Default        bool            `json:"default,omitempty"`
+	Fstype         string          `json:"fstype,omitempty"`
+	NodeSelector   string          `json:"nodeSelector,omitempty"`
+	DeviceSelector *DeviceSelector `json:"deviceSelector,omitempty"`
+	ThinPoolConfig *ThinPoolConfig `json:"thinPoolConfig,omitempty"`
+}
+
+// LVMClusterSpec is the user-configurable shape of the LVMCluster CR's storage.deviceClasses.
+// It is seeded with one deviceClass per host role (see deviceClassGroups) and can be
+// overridden per-cluster through the lvm MonitoredOperator's Properties JSON.
+type LVMClusterSpec struct {
+	DeviceClasses []LVMClusterDeviceClass `json:"deviceClasses"`
+}
+
+// lvmClusterSpec builds the LVMClusterSpec for cluster, applying any user-supplied override
+// and rejecting one that would have LVMS claim a host's installation disk
+func lvmClusterSpec(cluster *common.Cluster, groups []deviceClassGroup) (*LVMClusterSpec, error) {
+	spec := defaultLVMClusterSpec(groups)
+
+	properties := operatorProperties(cluster)
+	if properties != "" {
+		var override LVMClusterSpec
+		if err := json.Unmarshal([]byte(properties), &override); err != nil {
+			return nil, errors.Wrap(err, "failed to parse lvm operator properties")
+		}
+		if len(override.DeviceClasses) > 0 {
+			spec.DeviceClasses = override.DeviceClasses
+		}
+	}
+
+	if err := validateLVMClusterSpec(spec, cluster); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func defaultLVMClusterSpec(groups []deviceClassGroup) *LVMClusterSpec {
+	deviceClasses := make([]LVMClusterDeviceClass, 0, len(groups))
+	for i, group := range groups {
+		deviceClasses = append(deviceClasses, LVMClusterDeviceClass{
+			Name:         group.Name,
+			NodeSelector: group.NodeSelector,
+			Default:      i == 0,
+		})
+	}
+	return &LVMClusterSpec{DeviceClasses: deviceClasses}
+}
+
+// validateLVMClusterSpec rejects any deviceClass whose deviceSelector references a path that
+// is a host's installationDiskID, since LVMS would wipe it
+func validateLVMClusterSpec(spec *LVMClusterSpec, cluster *common.Cluster) error {
+	installationDisks := make(map[string]bool)
+	for _, host := range cluster.Hosts {
+		if host.InstallationDiskID != "" {
+			installationDisks[host.InstallationDiskID] = true
+		}
+	}
+
+	for _, deviceClass := range spec.DeviceClasses {
+		if deviceClass.DeviceSelector == nil {
+			continue
+		}
+		for _, path := range deviceSelectorPaths(deviceClass.DeviceSelector) {
+			if installationDisks[path] {
+				return errors.Errorf("deviceClass %q device selector targets %q, which is an installation disk", deviceClass.Name, path)
+			}
+		}
+	}
+	return nil
+}
+
+// deviceSelectorPaths returns every path a deviceSelector references, required and optional alike
+func deviceSelectorPaths(selector *DeviceSelector) []string {
+	return append(append([]string{}, selector.Paths...), selector.OptionalPaths...)
+}
+
+// operatorProperties returns the raw Properties JSON the cluster carries for this operator, if any
+func operatorProperties(cluster *common.Cluster) string {
+	for _, monitoredOperator := range cluster.MonitoredOperators {
+		if monitoredOperator != nil && monitoredOperator.Name == Operator.Name {
+			return monitoredOperator.Properties
+		}
+	}
+	return ""
+}
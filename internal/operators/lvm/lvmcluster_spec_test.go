@@ -0,0 +1,51 @@
+package lvm
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("lvmClusterSpec", func() {
+	diskID1 := "/dev/disk/by-id/test-disk-1"
+	diskID2 := "/dev/disk/by-id/test-disk-2"
+
+	groups := []deviceClassGroup{{Name: "vg1"}}
+
+	It("rejects a deviceSelector path that is a host's installation disk", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{
+			Hosts: []*models.Host{{InstallationDiskID: diskID1}},
+			MonitoredOperators: []*models.MonitoredOperator{
+				{Name: Operator.Name, Properties: `{"deviceClasses":[{"name":"vg1","default":true,"deviceSelector":{"paths":["` + diskID1 + `"]}}]}`},
+			},
+		}}
+
+		spec, err := lvmClusterSpec(cluster, groups)
+		Expect(err).To(HaveOccurred())
+		Expect(spec).To(BeNil())
+	})
+
+	It("applies a valid override from the operator's Properties", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{
+			Hosts: []*models.Host{{InstallationDiskID: diskID1}},
+			MonitoredOperators: []*models.MonitoredOperator{
+				{Name: Operator.Name, Properties: `{"deviceClasses":[{"name":"vg1","default":true,"deviceSelector":{"paths":["` + diskID2 + `"]}}]}`},
+			},
+		}}
+
+		spec, err := lvmClusterSpec(cluster, groups)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec.DeviceClasses).To(HaveLen(1))
+		Expect(spec.DeviceClasses[0].DeviceSelector.Paths).To(ConsistOf(diskID2))
+	})
+
+	It("defaults to the unconstrained deviceClasses when no override is set", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{Hosts: []*models.Host{{InstallationDiskID: diskID1}}}}
+
+		spec, err := lvmClusterSpec(cluster, groups)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec.DeviceClasses).To(HaveLen(1))
+		Expect(spec.DeviceClasses[0].DeviceSelector).To(BeNil())
+	})
+})
@@ -0,0 +1,243 @@
+package lvm
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+const (
+	Source string = "redhat-operators"
+)
+
+// deviceClassGroup describes one deviceClass of the LVMCluster CR, scoped by nodeSelector to
+// the hosts of a single role (master or worker)
+type deviceClassGroup struct {
+	Name         string
+	NodeSelector string
+}
+
+// deviceClassGroups derives the deviceClasses needed to cover every host role present in the
+// cluster: a single "vg1" deviceClass with no nodeSelector for Single Node Openshift, or one
+// deviceClass per role (named after it) for compact and worker-containing clusters
+func deviceClassGroups(cluster *common.Cluster) []deviceClassGroup {
+	if common.IsSingleNodeCluster(cluster) {
+		return []deviceClassGroup{{Name: "vg1"}}
+	}
+
+	roles := make(map[models.HostRole]bool)
+	for _, host := range cluster.Hosts {
+		roles[host.Role] = true
+	}
+
+	groups := make([]deviceClassGroup, 0, len(roles))
+	if roles[models.HostRoleMaster] {
+		groups = append(groups, deviceClassGroup{Name: "vg1-master", NodeSelector: "node-role.kubernetes.io/master"})
+	}
+	if roles[models.HostRoleWorker] {
+		groups = append(groups, deviceClassGroup{Name: "vg1-worker", NodeSelector: "node-role.kubernetes.io/worker"})
+	}
+	if len(groups) == 0 {
+		groups = append(groups, deviceClassGroup{Name: "vg1", NodeSelector: "node-role.kubernetes.io/master"})
+	}
+	return groups
+}
+
+// Manifests returns manifests needed to deploy ODF LVM
+func Manifests(spec *LVMClusterSpec, wipeGroups []wipeDeviceGroup) (map[string][]byte, []byte, error) {
+	lvmSubs, err := subscription()
+	if err != nil {
+		return nil, nil, err
+	}
+	lvmNs, err := namespace()
+	if err != nil {
+		return nil, nil, err
+	}
+	lvmGrp, err := group()
+	if err != nil {
+		return nil, nil, err
+	}
+	lvmCluster, err := lvmcluster(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	openshiftManifests := make(map[string][]byte)
+
+	openshiftManifests["50_openshift-lvm_subscription.yaml"] = lvmSubs
+	openshiftManifests["50_openshift-lvm_ns.yaml"] = lvmNs
+	openshiftManifests["50_openshift-lvm_operator_group.yaml"] = lvmGrp
+
+	for _, wipeGroup := range wipeGroups {
+		if len(wipeGroup.Paths) == 0 {
+			continue
+		}
+		wipeMC, mcErr := wipeMachineConfig(wipeGroup)
+		if mcErr != nil {
+			return nil, nil, mcErr
+		}
+		openshiftManifests[fmt.Sprintf("98_openshift-lvm_wipe_disks_%s.yaml", wipeGroup.MCPRole)] = wipeMC
+	}
+
+	return openshiftManifests, lvmCluster, nil
+}
+
+func subscription() ([]byte, error) {
+	data := map[string]string{
+		"OPERATOR_NAMESPACE":         Operator.Namespace,
+		"OPERATOR_SUBSCRIPTION_NAME": Operator.SubscriptionName,
+		"OPERATOR_SOURCE":            Source,
+	}
+	return executeTemplate(data, "lvmSubscription", lvmSubscription)
+}
+
+func namespace() ([]byte, error) {
+	data := map[string]string{
+		"OPERATOR_NAMESPACE": Operator.Namespace,
+	}
+	return executeTemplate(data, "lvmNamespace", lvmNamespace)
+}
+
+func group() ([]byte, error) {
+	data := map[string]string{
+		"OPERATOR_NAMESPACE": Operator.Namespace,
+	}
+	return executeTemplate(data, "lvmGroup", lvmOperatorGroup)
+}
+
+func lvmcluster(spec *LVMClusterSpec) ([]byte, error) {
+	data := struct {
+		OPERATOR_NAMESPACE string
+		DeviceClasses      []LVMClusterDeviceClass
+	}{
+		OPERATOR_NAMESPACE: Operator.Namespace,
+		DeviceClasses:      spec.DeviceClasses,
+	}
+	return executeTemplate(data, "lvmLVMCluster", lvmLVMCluster)
+}
+
+func wipeMachineConfig(wipeGroup wipeDeviceGroup) ([]byte, error) {
+	data := struct {
+		MCPRole string
+		Paths   []string
+	}{
+		MCPRole: wipeGroup.MCPRole,
+		Paths:   wipeGroup.Paths,
+	}
+	return executeTemplate(data, "lvmWipeMachineConfig", lvmWipeMachineConfig)
+}
+
+func executeTemplate(data interface{}, contentName, content string) ([]byte, error) {
+	tmpl, err := template.New(contentName).Parse(content)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	err = tmpl.Execute(buf, data)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+const lvmSubscription = `operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+name: "{{.OPERATOR_SUBSCRIPTION_NAME}}"
+namespace: "{{.OPERATOR_NAMESPACE}}"
+spec:
+  installPlanApproval: Automatic
+  name: odf-lvm-operator
+  source: "{{.OPERATOR_SOURCE}}"
+  sourceNamespace: openshift-marketplace
+  startingCSV: odf-lvm-operator.v4.12.0`
+
+const lvmNamespace = `apiVersion: v1
+kind: Namespace
+metadata:
+name: "{{.OPERATOR_NAMESPACE}}"
+labels:
+	openshift.io/cluster-monitoring: "true"`
+
+const lvmOperatorGroup = `operators.coreos.com/v1
+kind: OperatorGroup
+metadata:
+	name: openshift-storage-operatorgroup
+	namespace: "{{.OPERATOR_NAMESPACE}}"
+spec:
+	targetNamespaces:
+	- "{{.OPERATOR_NAMESPACE}}"`
+
+const lvmLVMCluster = `apiVersion: lvm.topolvm.io/v1alpha1
+kind: LVMCluster
+metadata:
+	name: lvmcluster-sample
+	namespace: "{{.OPERATOR_NAMESPACE}}"
+spec:
+	storage:
+	deviceClasses:
+	{{- range .DeviceClasses}}
+	- name: {{.Name}}
+	  default: {{.Default}}
+	{{- if .Fstype}}
+	  fstype: {{.Fstype}}
+	{{- end}}
+	{{- if .NodeSelector}}
+	  nodeSelector:
+	    nodeSelectorTerms:
+	    - matchExpressions:
+	      - key: {{.NodeSelector}}
+	        operator: Exists
+	{{- end}}
+	{{- if .DeviceSelector}}
+	  deviceSelector:
+	  {{- if .DeviceSelector.Paths}}
+	    paths:
+	    {{- range .DeviceSelector.Paths}}
+	    - {{.}}
+	    {{- end}}
+	  {{- end}}
+	  {{- if .DeviceSelector.OptionalPaths}}
+	    optionalPaths:
+	    {{- range .DeviceSelector.OptionalPaths}}
+	    - {{.}}
+	    {{- end}}
+	  {{- end}}
+	{{- end}}
+	{{- if .ThinPoolConfig}}
+	  thinPoolConfig:
+	    name: {{.ThinPoolConfig.Name}}
+	    sizePercent: {{.ThinPoolConfig.SizePercent}}
+	    overprovisionRatio: {{.ThinPoolConfig.OverprovisionRatio}}
+	{{- end}}
+	{{- end}}`
+
+const lvmWipeMachineConfig = `apiVersion: machineconfiguration.openshift.io/v1
+kind: MachineConfig
+metadata:
+	name: 98-lvm-wipe-disks-{{.MCPRole}}
+	labels:
+		machineconfiguration.openshift.io/role: {{.MCPRole}}
+spec:
+	config:
+		ignition:
+			version: 3.2.0
+		systemd:
+			units:
+			- name: lvm-wipe-disks.service
+			  enabled: true
+			  contents: |
+			    [Unit]
+			    Description=Wipe disks designated for ODF LVM before kubelet starts
+			    Before=kubelet.service
+			    [Service]
+			    Type=oneshot
+			    RemainAfterExit=true
+			    {{range .Paths}}ExecStart=-/usr/sbin/wipefs -a {{.}}
+			    ExecStart=-/usr/sbin/sgdisk --zap-all {{.}}
+			    {{end}}
+			    [Install]
+			    WantedBy=multi-user.target`
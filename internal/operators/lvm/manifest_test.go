@@ -0,0 +1,71 @@
+package lvm
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Manifests", func() {
+	table.DescribeTable("wipeMachineConfig renders", func(wipeGroup wipeDeviceGroup, expectedSubstrings []string) {
+		manifest, err := wipeMachineConfig(wipeGroup)
+		Expect(err).ToNot(HaveOccurred())
+		for _, expected := range expectedSubstrings {
+			Expect(string(manifest)).To(ContainSubstring(expected))
+		}
+	},
+		table.Entry("a single device",
+			wipeDeviceGroup{MCPRole: "master", Paths: []string{"/dev/disk/by-id/test-disk-2"}},
+			[]string{
+				"98-lvm-wipe-disks-master",
+				"machineconfiguration.openshift.io/role: master",
+				"ExecStart=-/usr/sbin/wipefs -a /dev/disk/by-id/test-disk-2",
+				"ExecStart=-/usr/sbin/sgdisk --zap-all /dev/disk/by-id/test-disk-2",
+				"Before=kubelet.service",
+			},
+		),
+		table.Entry("multiple devices on worker nodes",
+			wipeDeviceGroup{MCPRole: "worker", Paths: []string{"/dev/disk/by-id/test-disk-2", "/dev/disk/by-id/test-disk-3"}},
+			[]string{
+				"98-lvm-wipe-disks-worker",
+				"machineconfiguration.openshift.io/role: worker",
+				"ExecStart=-/usr/sbin/wipefs -a /dev/disk/by-id/test-disk-2",
+				"ExecStart=-/usr/sbin/wipefs -a /dev/disk/by-id/test-disk-3",
+			},
+		),
+	)
+
+	Context("wipeDeviceGroups", func() {
+		diskID1 := "/dev/disk/by-id/test-disk-1"
+		diskID2 := "/dev/disk/by-id/test-disk-2"
+		diskID3 := "/dev/disk/by-id/test-disk-3"
+
+		cluster := &common.Cluster{Cluster: models.Cluster{Hosts: []*models.Host{
+			{InstallationDiskID: diskID1},
+		}}}
+
+		It("never includes the installation disk even if it is allow-listed", func() {
+			op := newLvmOperatorWithConfig(common.GetTestLog(), &Config{WipeDevices: []string{diskID1, diskID2}}, nil)
+			spec := &LVMClusterSpec{DeviceClasses: []LVMClusterDeviceClass{
+				{Name: "vg1", DeviceSelector: &DeviceSelector{Paths: []string{diskID1, diskID2}}},
+			}}
+
+			groups := op.wipeDeviceGroups(cluster, spec)
+			Expect(groups).To(HaveLen(1))
+			Expect(groups[0].Paths).To(ConsistOf(diskID2))
+		})
+
+		It("excludes paths that are not allow-listed", func() {
+			op := newLvmOperatorWithConfig(common.GetTestLog(), &Config{WipeDevices: []string{diskID2}}, nil)
+			spec := &LVMClusterSpec{DeviceClasses: []LVMClusterDeviceClass{
+				{Name: "vg1", DeviceSelector: &DeviceSelector{Paths: []string{diskID2, diskID3}}},
+			}}
+
+			groups := op.wipeDeviceGroups(cluster, spec)
+			Expect(groups).To(HaveLen(1))
+			Expect(groups[0].Paths).To(ConsistOf(diskID2))
+		})
+	})
+})
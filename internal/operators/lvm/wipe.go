@@ -0,0 +1,64 @@
+package lvm
+
+import (
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+)
+
+// wipeDeviceGroup is the set of device paths that need wiping before kubelet starts on a given
+// MachineConfigPool role
+type wipeDeviceGroup struct {
+	MCPRole string
+	Paths   []string
+}
+
+// wipeDeviceGroups derives, per MachineConfigPool role, the device paths that are both
+// referenced by a deviceSelector in spec and allow-listed by Config.WipeDevices. Paths that are
+// any host's installationDiskID are always excluded, regardless of the allow-list.
+func (o *operator) wipeDeviceGroups(cluster *common.Cluster, spec *LVMClusterSpec) []wipeDeviceGroup {
+	installationDisks := make(map[string]bool)
+	for _, host := range cluster.Hosts {
+		if host.InstallationDiskID != "" {
+			installationDisks[host.InstallationDiskID] = true
+		}
+	}
+
+	allowed := make(map[string]bool, len(o.config.WipeDevices))
+	for _, path := range o.config.WipeDevices {
+		allowed[path] = true
+	}
+
+	pathsByRole := make(map[string][]string)
+	roleOrder := make([]string, 0, len(spec.DeviceClasses))
+	for _, deviceClass := range spec.DeviceClasses {
+		if deviceClass.DeviceSelector == nil {
+			continue
+		}
+		role := mcpRoleForNodeSelector(deviceClass.NodeSelector)
+		for _, path := range deviceSelectorPaths(deviceClass.DeviceSelector) {
+			if installationDisks[path] || !allowed[path] {
+				continue
+			}
+			if _, exists := pathsByRole[role]; !exists {
+				roleOrder = append(roleOrder, role)
+			}
+			pathsByRole[role] = append(pathsByRole[role], path)
+		}
+	}
+
+	groups := make([]wipeDeviceGroup, 0, len(roleOrder))
+	for _, role := range roleOrder {
+		groups = append(groups, wipeDeviceGroup{MCPRole: role, Paths: pathsByRole[role]})
+	}
+	return groups
+}
+
+// mcpRoleForNodeSelector maps a deviceClass's nodeSelector key to the MachineConfigPool role
+// that should receive the disk-wipe MachineConfig
+func mcpRoleForNodeSelector(nodeSelector string) string {
+	if strings.Contains(nodeSelector, "worker") {
+		return "worker"
+	}
+	return "master"
+}
@@ -0,0 +1,114 @@
+package odflvm
+
+import (
+	"encoding/json"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/pkg/errors"
+)
+
+// DeviceSelector narrows the deviceClass down to specific block devices, by path, instead of
+// letting LVMS claim every unclaimed disk on the node
+type DeviceSelector struct {
+	Paths         []string `json:"paths,omitempty"`
+	OptionalPaths []string `json:"optionalPaths,omitempty"`
+}
+
+// ThinPoolConfig configures the thin pool backing the deviceClass
+type ThinPoolConfig struct {
+	Name               string `json:"name"`
+	SizePercent        int64  `json:"sizePercent"`
+	OverprovisionRatio int64  `json:"overprovisionRatio"`
+}
+
+// LVMClusterDeviceClass is a single entry of the LVMCluster CR's storage.deviceClasses list
+type LVMClusterDeviceClass struct {
+	Name           string          `json:"name"`
+	Default        bool            `json:"default,omitempty"`
+	Fstype         string          `json:"fstype,omitempty"`
+	NodeSelector   string          `json:"nodeSelector,omitempty"`
+	DeviceSelector *DeviceSelector `json:"deviceSelector,omitempty"`
+	ThinPoolConfig *ThinPoolConfig `json:"thinPoolConfig,omitempty"`
+}
+
+// LVMClusterSpec is the user-configurable shape of the LVMCluster CR's storage.deviceClasses
+type LVMClusterSpec struct {
+	DeviceClasses []LVMClusterDeviceClass `json:"deviceClasses"`
+}
+
+// lvmClusterSpec builds the LVMClusterSpec for cluster, defaulting to one deviceClass per
+// deviceClassGroup (the historical unconstrained "vg1" deviceClass on Single Node Openshift, or
+// one per host role on multi-node clusters) built from the operator's Config, applying any
+// user-supplied override from the operator's Properties JSON, and rejecting one that would have
+// LVMS claim a host's installation disk
+func (o *operator) lvmClusterSpec(cluster *common.Cluster, groups []deviceClassGroup) (*LVMClusterSpec, error) {
+	spec := o.defaultLVMClusterSpec(groups)
+
+	properties := operatorProperties(cluster)
+	if properties != "" {
+		var override LVMClusterSpec
+		if err := json.Unmarshal([]byte(properties), &override); err != nil {
+			return nil, errors.Wrap(err, "failed to parse odflvm operator properties")
+		}
+		if len(override.DeviceClasses) > 0 {
+			spec.DeviceClasses = override.DeviceClasses
+		}
+	}
+
+	if err := validateLVMClusterSpec(spec, cluster); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func (o *operator) defaultLVMClusterSpec(groups []deviceClassGroup) *LVMClusterSpec {
+	deviceClasses := make([]LVMClusterDeviceClass, 0, len(groups))
+	for i, group := range groups {
+		deviceClass := LVMClusterDeviceClass{
+			Name:         group.Name,
+			NodeSelector: group.NodeSelector,
+			Default:      i == 0,
+		}
+		if len(o.config.DeviceSelectorPaths) > 0 || len(o.config.DeviceSelectorOptionalPaths) > 0 {
+			deviceClass.DeviceSelector = &DeviceSelector{
+				Paths:         o.config.DeviceSelectorPaths,
+				OptionalPaths: o.config.DeviceSelectorOptionalPaths,
+			}
+		}
+		deviceClasses = append(deviceClasses, deviceClass)
+	}
+	return &LVMClusterSpec{DeviceClasses: deviceClasses}
+}
+
+// operatorProperties returns the raw Properties JSON the cluster carries for this operator, if any
+func operatorProperties(cluster *common.Cluster) string {
+	for _, monitoredOperator := range cluster.MonitoredOperators {
+		if monitoredOperator != nil && monitoredOperator.Name == Operator.Name {
+			return monitoredOperator.Properties
+		}
+	}
+	return ""
+}
+
+// validateLVMClusterSpec rejects any deviceClass whose deviceSelector references a path that
+// is a host's installationDiskID, since LVMS would wipe it
+func validateLVMClusterSpec(spec *LVMClusterSpec, cluster *common.Cluster) error {
+	installationDisks := make(map[string]bool)
+	for _, host := range cluster.Hosts {
+		if host.InstallationDiskID != "" {
+			installationDisks[host.InstallationDiskID] = true
+		}
+	}
+
+	for _, deviceClass := range spec.DeviceClasses {
+		if deviceClass.DeviceSelector == nil {
+			continue
+		}
+		for _, path := range deviceSelectorPaths(deviceClass.DeviceSelector) {
+			if installationDisks[path] {
+				return errors.Errorf("deviceClass %q device selector targets %q, which is an installation disk", deviceClass.Name, path)
+			}
+		}
+	}
+	return nil
+}
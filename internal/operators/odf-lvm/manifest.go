@@ -3,14 +3,55 @@ package odflvm
 import (
 	"bytes"
 	"text/template"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
 )
 
+// machineConfigPoolRole is the MachineConfigPool role targeted by the disk-wipe MachineConfig.
+// It is "master" on every cluster, multi-node ones included: the paths of a worker deviceClass
+// are wiped by the master-pool MachineConfig, and no MachineConfig targets the worker pool.
+const machineConfigPoolRole = "master"
+
 const (
 	Source string = "redhat-operators"
 )
 
+// deviceClassGroup describes one deviceClass of the LVMCluster CR, scoped by nodeSelector to
+// the hosts of a single role (master or worker)
+type deviceClassGroup struct {
+	Name         string
+	NodeSelector string
+}
+
+// deviceClassGroups derives the deviceClasses needed to cover every host role present in the
+// cluster: a single "vg1" deviceClass with no nodeSelector for Single Node Openshift, or one
+// deviceClass per role (named after it) for compact and worker-containing clusters
+func deviceClassGroups(cluster *common.Cluster) []deviceClassGroup {
+	if common.IsSingleNodeCluster(cluster) {
+		return []deviceClassGroup{{Name: "vg1"}}
+	}
+
+	roles := make(map[models.HostRole]bool)
+	for _, host := range cluster.Hosts {
+		roles[host.Role] = true
+	}
+
+	groups := make([]deviceClassGroup, 0, len(roles))
+	if roles[models.HostRoleMaster] {
+		groups = append(groups, deviceClassGroup{Name: "vg1-master", NodeSelector: "node-role.kubernetes.io/master"})
+	}
+	if roles[models.HostRoleWorker] {
+		groups = append(groups, deviceClassGroup{Name: "vg1-worker", NodeSelector: "node-role.kubernetes.io/worker"})
+	}
+	if len(groups) == 0 {
+		groups = append(groups, deviceClassGroup{Name: "vg1", NodeSelector: "node-role.kubernetes.io/master"})
+	}
+	return groups
+}
+
 // Manifests returns manifests needed to deploy ODF LVM
-func Manifests() (map[string][]byte, []byte, error) {
+func Manifests(spec *LVMClusterSpec, wipeDevicePaths []string) (map[string][]byte, []byte, error) {
 	odflvmSubs, err := subscription()
 
 	if err != nil {
@@ -24,7 +65,7 @@ func Manifests() (map[string][]byte, []byte, error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	odflvmLVMC, err := lvmcluster()
+	odflvmLVMC, err := lvmcluster(spec)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -34,6 +75,15 @@ func Manifests() (map[string][]byte, []byte, error) {
 	openshiftManifests["50_openshift-odflvm_subscription.yaml"] = odflvmSubs
 	openshiftManifests["50_openshift-odflvm_ns.yaml"] = odflvmNs
 	openshiftManifests["50_openshift-odflvm_operator_group.yaml"] = odflvmGrp
+
+	if len(wipeDevicePaths) > 0 {
+		wipeMC, mcErr := wipeMachineConfig(wipeDevicePaths)
+		if mcErr != nil {
+			return nil, nil, mcErr
+		}
+		openshiftManifests["98_openshift-odflvm_wipe_disks.yaml"] = wipeMC
+	}
+
 	return openshiftManifests, []byte(odflvmLVMC), nil
 }
 
@@ -60,14 +110,29 @@ func group() ([]byte, error) {
 	return executeTemplate(data, "odflvmGroup", odflvmOperatorGroup)
 }
 
-func lvmcluster() ([]byte, error) {
-	data := map[string]string{
-		"OPERATOR_NAMESPACE": Operator.Namespace,
+func lvmcluster(spec *LVMClusterSpec) ([]byte, error) {
+	data := struct {
+		OPERATOR_NAMESPACE string
+		DeviceClasses      []LVMClusterDeviceClass
+	}{
+		OPERATOR_NAMESPACE: Operator.Namespace,
+		DeviceClasses:      spec.DeviceClasses,
 	}
 	return executeTemplate(data, "odflvmLVMCluster", odflvmLVMCluster)
 }
 
-func executeTemplate(data map[string]string, contentName, content string) ([]byte, error) {
+func wipeMachineConfig(paths []string) ([]byte, error) {
+	data := struct {
+		MCPRole string
+		Paths   []string
+	}{
+		MCPRole: machineConfigPoolRole,
+		Paths:   paths,
+	}
+	return executeTemplate(data, "odflvmWipeMachineConfig", odflvmWipeMachineConfig)
+}
+
+func executeTemplate(data interface{}, contentName, content string) ([]byte, error) {
 	tmpl, err := template.New(contentName).Parse(content)
 	if err != nil {
 		return nil, err
@@ -116,4 +181,65 @@ metadata:
 spec:
 	storage:
 	deviceClasses:
-	- name: vg1`
+	{{- range .DeviceClasses}}
+	- name: {{.Name}}
+	  default: {{.Default}}
+	{{- if .Fstype}}
+	  fstype: {{.Fstype}}
+	{{- end}}
+	{{- if .NodeSelector}}
+	  nodeSelector:
+	    nodeSelectorTerms:
+	    - matchExpressions:
+	      - key: {{.NodeSelector}}
+	        operator: Exists
+	{{- end}}
+	{{- if .DeviceSelector}}
+	  deviceSelector:
+	  {{- if .DeviceSelector.Paths}}
+	    paths:
+	    {{- range .DeviceSelector.Paths}}
+	    - {{.}}
+	    {{- end}}
+	  {{- end}}
+	  {{- if .DeviceSelector.OptionalPaths}}
+	    optionalPaths:
+	    {{- range .DeviceSelector.OptionalPaths}}
+	    - {{.}}
+	    {{- end}}
+	  {{- end}}
+	{{- end}}
+	{{- if .ThinPoolConfig}}
+	  thinPoolConfig:
+	    name: {{.ThinPoolConfig.Name}}
+	    sizePercent: {{.ThinPoolConfig.SizePercent}}
+	    overprovisionRatio: {{.ThinPoolConfig.OverprovisionRatio}}
+	{{- end}}
+	{{- end}}`
+
+const odflvmWipeMachineConfig = `apiVersion: machineconfiguration.openshift.io/v1
+kind: MachineConfig
+metadata:
+	name: 98-odflvm-wipe-disks-{{.MCPRole}}
+	labels:
+		machineconfiguration.openshift.io/role: {{.MCPRole}}
+spec:
+	config:
+		ignition:
+			version: 3.2.0
+		systemd:
+			units:
+			- name: lvm-wipe-disks.service
+			  enabled: true
+			  contents: |
+			    [Unit]
+			    Description=Wipe disks designated for ODF LVM before kubelet starts
+			    Before=kubelet.service
+			    [Service]
+			    Type=oneshot
+			    RemainAfterExit=true
+			    {{range .Paths}}ExecStart=-/usr/sbin/wipefs -a {{.}}
+			    ExecStart=-/usr/sbin/sgdisk --zap-all {{.}}
+			    {{end}}
+			    [Install]
+			    WantedBy=multi-user.target`
@@ -0,0 +1,82 @@
+package odflvm
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Manifests", func() {
+	table.DescribeTable("wipeMachineConfig renders", func(paths []string, expectedSubstrings []string) {
+		manifest, err := wipeMachineConfig(paths)
+		Expect(err).ToNot(HaveOccurred())
+		for _, expected := range expectedSubstrings {
+			Expect(string(manifest)).To(ContainSubstring(expected))
+		}
+	},
+		table.Entry("a single device",
+			[]string{"/dev/disk/by-id/test-disk-2"},
+			[]string{
+				"98-odflvm-wipe-disks-master",
+				"machineconfiguration.openshift.io/role: master",
+				"ExecStart=-/usr/sbin/wipefs -a /dev/disk/by-id/test-disk-2",
+				"ExecStart=-/usr/sbin/sgdisk --zap-all /dev/disk/by-id/test-disk-2",
+				"Before=kubelet.service",
+			},
+		),
+		table.Entry("multiple devices",
+			[]string{"/dev/disk/by-id/test-disk-2", "/dev/disk/by-id/test-disk-3"},
+			[]string{
+				"ExecStart=-/usr/sbin/wipefs -a /dev/disk/by-id/test-disk-2",
+				"ExecStart=-/usr/sbin/wipefs -a /dev/disk/by-id/test-disk-3",
+			},
+		),
+	)
+
+	Context("wipeDevicePaths", func() {
+		diskID1 := "/dev/disk/by-id/test-disk-1"
+		diskID2 := "/dev/disk/by-id/test-disk-2"
+		diskID3 := "/dev/disk/by-id/test-disk-3"
+
+		cluster := &common.Cluster{Cluster: models.Cluster{Hosts: []*models.Host{
+			{InstallationDiskID: diskID1},
+		}}}
+
+		It("never includes the installation disk even if it is allow-listed", func() {
+			op := &operator{log: common.GetTestLog(), config: Config{WipeDevices: []string{diskID1, diskID2}}}
+			spec := &LVMClusterSpec{DeviceClasses: []LVMClusterDeviceClass{
+				{Name: "vg1", DeviceSelector: &DeviceSelector{Paths: []string{diskID1, diskID2}}},
+			}}
+
+			paths := op.wipeDevicePaths(cluster, spec)
+			Expect(paths).To(ConsistOf(diskID2))
+		})
+
+		It("excludes paths that are not allow-listed", func() {
+			op := &operator{log: common.GetTestLog(), config: Config{WipeDevices: []string{diskID2}}}
+			spec := &LVMClusterSpec{DeviceClasses: []LVMClusterDeviceClass{
+				{Name: "vg1", DeviceSelector: &DeviceSelector{Paths: []string{diskID2, diskID3}}},
+			}}
+
+			paths := op.wipeDevicePaths(cluster, spec)
+			Expect(paths).To(ConsistOf(diskID2))
+		})
+	})
+
+	Context("Manifests", func() {
+		It("includes a wipe-disks MachineConfig only when there are paths to wipe", func() {
+			spec := &LVMClusterSpec{DeviceClasses: []LVMClusterDeviceClass{{Name: "vg1", Default: true}}}
+
+			manifests, _, err := Manifests(spec, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manifests).ToNot(HaveKey("98_openshift-odflvm_wipe_disks.yaml"))
+
+			manifests, _, err = Manifests(spec, []string{"/dev/disk/by-id/test-disk-2"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manifests).To(HaveKey("98_openshift-odflvm_wipe_disks.yaml"))
+			Expect(string(manifests["98_openshift-odflvm_wipe_disks.yaml"])).To(ContainSubstring("ExecStart=-/usr/sbin/wipefs -a /dev/disk/by-id/test-disk-2"))
+		})
+	})
+})
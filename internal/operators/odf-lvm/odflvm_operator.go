@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/go-version"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/models"
@@ -50,13 +51,41 @@ func (o *operator) GetHostValidationID() string {
 	return string(models.HostValidationIDLsoRequirementsSatisfied)
 }
 
-// ValidateCluster always return "valid" result
+// ValidateCluster rejects clusters running an Openshift version too old for ODF LVM, and
+// multi-node clusters unless the deployment has opted into EnableMultiNode
 func (o *operator) ValidateCluster(_ context.Context, cluster *common.Cluster) (api.ValidationResult, error) {
+	ocpVersion, err := version.NewVersion(cluster.OpenshiftVersion)
+	if err != nil {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{err.Error()}}, nil
+	}
+
 	if common.IsSingleNodeCluster(cluster) {
-		return api.ValidationResult{Status: api.Success, ValidationId: o.GetClusterValidationID(), Reasons: []string{}}, nil
-	} else {
-		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetClusterValidationID(), Reasons: []string{}}, nil
+		minOpenshiftVersion, verErr := version.NewVersion(ODFLVMMinOpenshiftVersion)
+		if verErr != nil {
+			return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{verErr.Error()}}, nil
+		}
+		if ocpVersion.LessThan(minOpenshiftVersion) {
+			message := fmt.Sprintf("ODF LVM operator is only supported for openshift versions %s and above", ODFLVMMinOpenshiftVersion)
+			return api.ValidationResult{Status: api.Failure, ValidationId: o.GetClusterValidationID(), Reasons: []string{message}}, nil
+		}
+		return api.ValidationResult{Status: api.Success, ValidationId: o.GetClusterValidationID()}, nil
+	}
+
+	if !o.config.EnableMultiNode {
+		message := "ODF LVM operator is only supported for Single Node Openshift deployment"
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetClusterValidationID(), Reasons: []string{message}}, nil
+	}
+
+	minOpenshiftVersionMultiNode, err := version.NewVersion(ODFLVMMinOpenshiftVersionMultiNode)
+	if err != nil {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{err.Error()}}, nil
+	}
+	if ocpVersion.LessThan(minOpenshiftVersionMultiNode) {
+		message := fmt.Sprintf("ODF LVM operator on multi-node clusters is only supported for openshift versions %s and above", ODFLVMMinOpenshiftVersionMultiNode)
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetClusterValidationID(), Reasons: []string{message}}, nil
 	}
+
+	return api.ValidationResult{Status: api.Success, ValidationId: o.GetClusterValidationID()}, nil
 }
 
 // ValidateHost always return "valid" result
@@ -98,8 +127,12 @@ func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, ho
 }
 
 // GenerateManifests generates manifests for the operator
-func (o *operator) GenerateManifests() (map[string][]byte, []byte, error) {
-	return Manifests()
+func (o *operator) GenerateManifests(cluster *common.Cluster) (map[string][]byte, []byte, error) {
+	spec, err := o.lvmClusterSpec(cluster, deviceClassGroups(cluster))
+	if err != nil {
+		return nil, nil, err
+	}
+	return Manifests(spec, o.wipeDevicePaths(cluster, spec))
 }
 
 // GetProperties provides description of operator properties: none required
@@ -122,18 +155,24 @@ func (o *operator) GetHostRequirements(ctx context.Context, cluster *common.Clus
 
 // GetPreflightRequirements returns operator hardware requirements that can be determined with cluster data only
 func (o *operator) GetPreflightRequirements(context.Context, *common.Cluster) (*models.OperatorHardwareRequirements, error) {
+	qualitative := []string{
+		"At least 1 non-bootable disk (SSD, HDD or NVMe) wih no partitions or filesystems",
+	}
+	quantitative := &models.ClusterHostRequirementsDetails{
+		CPUCores: o.config.ODFLVMCPUPerHost,
+		RAMMib:   o.config.ODFLVMMemoryMiBPerHost,
+	}
 	return &models.OperatorHardwareRequirements{
 		OperatorName: o.GetName(),
 		Dependencies: o.GetDependencies(),
 		Requirements: &models.HostTypeHardwareRequirementsWrapper{
 			Master: &models.HostTypeHardwareRequirements{
-				Quantitative: &models.ClusterHostRequirementsDetails{
-					CPUCores: o.config.ODFLVMCPUPerHost,
-					RAMMib:   o.config.ODFLVMMemoryMiBPerHost,
-				},
-				Qualitative: []string{
-					"At least 1 non-bootable disk wih no partitions or filesystems",
-				},
+				Quantitative: quantitative,
+				Qualitative:  qualitative,
+			},
+			Worker: &models.HostTypeHardwareRequirements{
+				Quantitative: quantitative,
+				Qualitative:  qualitative,
 			},
 		},
 	}, nil
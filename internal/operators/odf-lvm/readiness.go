@@ -0,0 +1,81 @@
+package odflvm
+
+import "fmt"
+
+const (
+	// DeploymentNameLVMSOperator is the controller Deployment the OLM CSV installs
+	DeploymentNameLVMSOperator = "lvms-operator"
+	// DeploymentNameVGManager is the per-node volume-group manager Deployment the CSV installs
+	DeploymentNameVGManager = "vg-manager"
+
+	// CSVPhaseSucceeded is the ClusterServiceVersion phase that marks a successful install
+	CSVPhaseSucceeded = "Succeeded"
+)
+
+// NamespacedName identifies a single namespaced Kubernetes object
+type NamespacedName struct {
+	Name      string
+	Namespace string
+}
+
+// ReadinessCriteria is what the monitoring subsystem should watch, beyond the OLM Subscription
+// itself, before marking the ODF LVM MonitoredOperator "Available": the installed CSV and the
+// Deployments it brings up
+type ReadinessCriteria struct {
+	CSVNamePattern string
+	Deployments    []NamespacedName
+}
+
+// GetReadinessCriteria reports the CSV and Deployments the monitoring subsystem should watch,
+// on top of Subscription state, before considering the operator "Available"
+func (o *operator) GetReadinessCriteria() ReadinessCriteria {
+	return ReadinessCriteria{
+		CSVNamePattern: "odf-lvm-operator.v*",
+		Deployments: []NamespacedName{
+			{Name: DeploymentNameLVMSOperator, Namespace: Operator.Namespace},
+			{Name: DeploymentNameVGManager, Namespace: Operator.Namespace},
+		},
+	}
+}
+
+// CSVStatus is the slice of a ClusterServiceVersion's status the monitoring subsystem needs
+type CSVStatus struct {
+	Name  string
+	Phase string
+}
+
+// DeploymentStatus is the slice of a Deployment's status the monitoring subsystem needs
+type DeploymentStatus struct {
+	Name              string
+	Namespace         string
+	Replicas          int32
+	AvailableReplicas int32
+}
+
+// EvaluateReadiness reports whether the operator is fully available given the observed CSV and
+// Deployment states, and a distinct, actionable reason when it is not
+func (o *operator) EvaluateReadiness(csv *CSVStatus, deployments []DeploymentStatus) (bool, string) {
+	if csv == nil {
+		return false, "Waiting for odf-lvm-operator ClusterServiceVersion to be created"
+	}
+	if csv.Phase != CSVPhaseSucceeded {
+		return false, fmt.Sprintf("CSV %s is in phase %s: CSV InstallFailed", csv.Name, csv.Phase)
+	}
+
+	byName := make(map[string]DeploymentStatus, len(deployments))
+	for _, deployment := range deployments {
+		byName[deployment.Name] = deployment
+	}
+
+	for _, want := range o.GetReadinessCriteria().Deployments {
+		got, found := byName[want.Name]
+		if !found {
+			return false, fmt.Sprintf("%s Deployment not found in namespace %s", want.Name, want.Namespace)
+		}
+		if got.AvailableReplicas != got.Replicas {
+			return false, fmt.Sprintf("%s not rolled out on every node: %d/%d replicas available", want.Name, got.AvailableReplicas, got.Replicas)
+		}
+	}
+
+	return true, ""
+}
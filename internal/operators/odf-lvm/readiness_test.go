@@ -0,0 +1,59 @@
+package odflvm
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Readiness", func() {
+	operator := NewODFLVMOperator()
+
+	succeededCSV := &CSVStatus{Name: "odf-lvm-operator.v4.10.0", Phase: CSVPhaseSucceeded}
+	rolledOutDeployments := []DeploymentStatus{
+		{Name: DeploymentNameLVMSOperator, Namespace: Operator.Namespace, Replicas: 1, AvailableReplicas: 1},
+		{Name: DeploymentNameVGManager, Namespace: Operator.Namespace, Replicas: 3, AvailableReplicas: 3},
+	}
+
+	table.DescribeTable("evaluate readiness when ", func(csv *CSVStatus, deployments []DeploymentStatus, expectedReady bool, expectedReason string) {
+		ready, reason := operator.EvaluateReadiness(csv, deployments)
+		Expect(ready).Should(Equal(expectedReady))
+		Expect(reason).Should(Equal(expectedReason))
+	},
+		table.Entry("CSV not yet created",
+			nil,
+			rolledOutDeployments,
+			false,
+			"Waiting for odf-lvm-operator ClusterServiceVersion to be created",
+		),
+		table.Entry("CSV failed to install",
+			&CSVStatus{Name: "odf-lvm-operator.v4.10.0", Phase: "Failed"},
+			rolledOutDeployments,
+			false,
+			"CSV odf-lvm-operator.v4.10.0 is in phase Failed: CSV InstallFailed",
+		),
+		table.Entry("vg-manager not rolled out on every node",
+			succeededCSV,
+			[]DeploymentStatus{
+				{Name: DeploymentNameLVMSOperator, Namespace: Operator.Namespace, Replicas: 1, AvailableReplicas: 1},
+				{Name: DeploymentNameVGManager, Namespace: Operator.Namespace, Replicas: 3, AvailableReplicas: 2},
+			},
+			false,
+			"vg-manager not rolled out on every node: 2/3 replicas available",
+		),
+		table.Entry("lvms-operator deployment missing",
+			succeededCSV,
+			[]DeploymentStatus{
+				{Name: DeploymentNameVGManager, Namespace: Operator.Namespace, Replicas: 3, AvailableReplicas: 3},
+			},
+			false,
+			"lvms-operator Deployment not found in namespace "+Operator.Namespace,
+		),
+		table.Entry("CSV succeeded and every deployment rolled out",
+			succeededCSV,
+			rolledOutDeployments,
+			true,
+			"",
+		),
+	)
+})
@@ -2,19 +2,64 @@ package odflvm
 
 import "github.com/openshift/assisted-service/models"
 
+const (
+	SsdDrive  = models.DriveTypeSSD
+	HddDrive  = models.DriveTypeHDD
+	NvmeDrive = models.DriveTypeNVMe
+)
+
+// ODFLVMMinOpenshiftVersion is the minimum OCP version that supports the ODF LVM operator
+const ODFLVMMinOpenshiftVersion = "4.10.0"
+
+// ODFLVMMinOpenshiftVersionMultiNode is the minimum OCP version that supports ODF LVM on
+// multi-node (compact or standard) clusters, as opposed to Single Node Openshift only
+const ODFLVMMinOpenshiftVersionMultiNode = "4.13.0"
+
 type Config struct {
 	ODFLVMCPUPerHost       int64 `envconfig:"ODF_LVM_CPU_Per_Host" default:""`
 	ODFLVMMemoryMiBPerHost int64 `envconfig:"ODF_LVM_Memory_MiB_Per_Host" default:""`
+
+	// EnableMultiNode allows ODF LVM to be validated and deployed on compact and
+	// worker-containing clusters, in addition to Single Node Openshift
+	EnableMultiNode bool `envconfig:"ODF_LVM_ENABLE_MULTI_NODE" default:"false"`
+
+	// AdditionalValidDriveTypes lets deployments opt extra models.DriveType values into
+	// getValidDiskCount, on top of the SSD/HDD/NVMe types counted by default.
+	AdditionalValidDriveTypes []string `envconfig:"ODF_LVM_ADDITIONAL_VALID_DRIVE_TYPES" default:""`
+
+	// DeviceSelectorPaths and DeviceSelectorOptionalPaths steer every default deviceClass at
+	// specific block devices instead of claiming every unclaimed disk on the node.
+	DeviceSelectorPaths         []string `envconfig:"ODF_LVM_DEVICE_SELECTOR_PATHS" default:""`
+	DeviceSelectorOptionalPaths []string `envconfig:"ODF_LVM_DEVICE_SELECTOR_OPTIONAL_PATHS" default:""`
+
+	// WipeDevices allow-lists the device paths that the pre-installation disk-wipe
+	// MachineConfig is permitted to run wipefs/sgdisk against. A deviceClass's deviceSelector
+	// path is only wiped if it also appears here, and the installation disk is never wiped
+	// regardless of this setting.
+	WipeDevices []string `envconfig:"ODF_LVM_WIPE_DEVICES" default:""`
 }
 
-// count all disks of drive type ssd or hdd
+// count the non-installation disks of non-zero size whose drive type is ssd, hdd, nvme or one
+// listed in Config.AdditionalValidDriveTypes
 func (o *operator) getValidDiskCount(disks []*models.Disk, installationDiskID string) int64 {
 	var countDisks int64
 
 	for _, disk := range disks {
-		if (disk.DriveType == SsdDrive || disk.DriveType == HddDrive) && installationDiskID != disk.ID && disk.SizeBytes != 0 {
+		if o.isValidDriveType(disk.DriveType) && installationDiskID != disk.ID && disk.SizeBytes != 0 {
 			countDisks++
 		}
 	}
 	return countDisks
 }
+
+func (o *operator) isValidDriveType(driveType models.DriveType) bool {
+	if driveType == SsdDrive || driveType == HddDrive || driveType == NvmeDrive {
+		return true
+	}
+	for _, additional := range o.config.AdditionalValidDriveTypes {
+		if string(driveType) == additional {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package odflvm
+
+import "github.com/openshift/assisted-service/internal/common"
+
+// wipeDevicePaths returns the device paths from every deviceClass's deviceSelector that are
+// both allow-listed by Config.WipeDevices and not any host's installation disk
+func (o *operator) wipeDevicePaths(cluster *common.Cluster, spec *LVMClusterSpec) []string {
+	installationDisks := make(map[string]bool)
+	for _, host := range cluster.Hosts {
+		if host.InstallationDiskID != "" {
+			installationDisks[host.InstallationDiskID] = true
+		}
+	}
+
+	allowed := make(map[string]bool, len(o.config.WipeDevices))
+	for _, path := range o.config.WipeDevices {
+		allowed[path] = true
+	}
+
+	var paths []string
+	for _, deviceClass := range spec.DeviceClasses {
+		if deviceClass.DeviceSelector == nil {
+			continue
+		}
+		for _, path := range deviceSelectorPaths(deviceClass.DeviceSelector) {
+			if allowed[path] && !installationDisks[path] {
+				paths = append(paths, path)
+			}
+		}
+	}
+	return paths
+}
+
+// deviceSelectorPaths returns every path a deviceSelector references, required and optional alike
+func deviceSelectorPaths(selector *DeviceSelector) []string {
+	return append(append([]string{}, selector.Paths...), selector.OptionalPaths...)
+}